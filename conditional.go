@@ -0,0 +1,68 @@
+package jsval
+
+// If begins a conditional constraint: cond is evaluated first against
+// the value being validated, and depending on whether it passes,
+// either the Then or Else branch (whichever is set) is applied to
+// that same value. This mirrors JSON Schema's `if`/`then`/`else`
+// keywords, and composes with any Constraint since ConditionalConstraint
+// itself implements Constraint.
+func If(cond Constraint) *ConditionalConstraint {
+	return &ConditionalConstraint{cond: cond}
+}
+
+// ConditionalConstraint implements `if`/`then`/`else` composition.
+type ConditionalConstraint struct {
+	cond         Constraint
+	thenC        Constraint
+	elseC        Constraint
+	defaultValue interface{}
+	hasDefault   bool
+}
+
+// Then specifies the constraint applied when cond passes.
+func (c *ConditionalConstraint) Then(t Constraint) *ConditionalConstraint {
+	c.thenC = t
+	return c
+}
+
+// Else specifies the constraint applied when cond fails.
+func (c *ConditionalConstraint) Else(e Constraint) *ConditionalConstraint {
+	c.elseC = e
+	return c
+}
+
+// Default specifies the default value to use for this constraint
+// when the value being validated is absent.
+func (c *ConditionalConstraint) Default(v interface{}) *ConditionalConstraint {
+	c.defaultValue = v
+	c.hasDefault = true
+	return c
+}
+
+// HasDefault returns true if a default value has been specified
+func (c *ConditionalConstraint) HasDefault() bool {
+	return c.hasDefault
+}
+
+// DefaultValue returns the default value associated with this constraint
+func (c *ConditionalConstraint) DefaultValue() interface{} {
+	return c.defaultValue
+}
+
+// branch returns whichever of Then/Else applies to v, or nil if cond
+// passed/failed but no matching branch was configured.
+func (c *ConditionalConstraint) branch(v interface{}) Constraint {
+	if c.cond == nil || c.cond.Validate(v) == nil {
+		return c.thenC
+	}
+	return c.elseC
+}
+
+// Validate validates the given value against this ConditionalConstraint
+func (c *ConditionalConstraint) Validate(v interface{}) error {
+	branch := c.branch(v)
+	if branch == nil {
+		return nil
+	}
+	return branch.Validate(v)
+}
@@ -0,0 +1,77 @@
+package jsval_test
+
+import (
+	"testing"
+
+	"github.com/GlenDC/go-jsval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionalConstraintThenElse(t *testing.T) {
+	c := jsval.If(jsval.String().RegexpString(`^a`)).
+		Then(jsval.String().MinLength(3)).
+		Else(jsval.String().MaxLength(1))
+
+	assert.NoError(t, c.Validate("abc"), "matches cond, satisfies then")
+	assert.Error(t, c.Validate("ab"), "matches cond, fails then's minLength")
+	assert.NoError(t, c.Validate("z"), "fails cond, satisfies else")
+	assert.Error(t, c.Validate("zz"), "fails cond, fails else's maxLength")
+}
+
+func TestConditionalConstraintNoMatchingBranch(t *testing.T) {
+	c := jsval.If(jsval.String().RegexpString(`^a`)).
+		Then(jsval.String().MinLength(3))
+
+	assert.NoError(t, c.Validate("z"), "cond fails and no else is configured, so nothing applies")
+}
+
+func TestObjectIfThenElse(t *testing.T) {
+	c := jsval.Object().
+		AddProp("role", jsval.String()).
+		AddProp("level", jsval.String())
+	c.If(jsval.Object().
+		AddProp("role", jsval.String().RegexpString(`^admin$`)).
+		AddProp("level", jsval.String())).
+		Then(jsval.Object().
+			AddProp("role", jsval.String()).
+			AddProp("level", jsval.String().RegexpString(`^(high|super)$`)))
+
+	assert.NoError(t, c.Validate(map[string]interface{}{
+		"role": "admin", "level": "high",
+	}))
+	assert.Error(t, c.Validate(map[string]interface{}{
+		"role": "admin", "level": "low",
+	}), "admin role requires a high/super level")
+	assert.NoError(t, c.Validate(map[string]interface{}{
+		"role": "member", "level": "low",
+	}), "non-admin role isn't subject to the then branch")
+}
+
+func TestObjectPropertyNames(t *testing.T) {
+	c := jsval.Object().
+		AdditionalProperties(jsval.String()).
+		PropertyNames(jsval.String().RegexpString(`^[a-z]+$`))
+
+	assert.NoError(t, c.Validate(map[string]interface{}{"abc": "x"}))
+	assert.Error(t, c.Validate(map[string]interface{}{"ABC": "x"}), "property name violating propertyNames should fail")
+}
+
+func TestObjectUnevaluatedPropertiesRejectsByDefault(t *testing.T) {
+	c := jsval.Object().
+		AddProp("name", jsval.String()).
+		UnevaluatedProperties(nil)
+
+	assert.NoError(t, c.Validate(map[string]interface{}{"name": "a"}))
+	assert.Error(t, c.Validate(map[string]interface{}{"name": "a", "extra": "b"}),
+		"unevaluated property should be rejected when UnevaluatedProperties(nil)")
+}
+
+func TestObjectUnevaluatedPropertiesWithConstraint(t *testing.T) {
+	c := jsval.Object().
+		AddProp("name", jsval.String()).
+		UnevaluatedProperties(jsval.String().MaxLength(2))
+
+	assert.NoError(t, c.Validate(map[string]interface{}{"name": "a", "extra": "bc"}))
+	assert.Error(t, c.Validate(map[string]interface{}{"name": "a", "extra": "bcd"}),
+		"unevaluated property must satisfy the configured constraint")
+}
@@ -0,0 +1,58 @@
+package jsval
+
+import (
+	"reflect"
+	"regexp"
+	"sync"
+)
+
+// Constraint is the interface implemented by every validation node
+// in this package (ObjectConstraint, StringConstraint, etc).
+type Constraint interface {
+	Validate(v interface{}) error
+	HasDefault() bool
+	DefaultValue() interface{}
+}
+
+// FieldNamesFromStructFunc returns the list of property names that
+// should be considered when validating the given struct value.
+type FieldNamesFromStructFunc func(reflect.Value) []string
+
+// FieldIndexFromNameFunc returns the reflect.Value.FieldByIndex path
+// matching the given property name, or nil if there is no such field.
+// A multi-element path lets the field live inside a flattened
+// anonymous embedded struct rather than directly on rv.
+type FieldIndexFromNameFunc func(reflect.Value, string) []int
+
+// ObjectConstraint validates that a given value behaves like a JSON
+// object: a Go map with string keys, or a struct.
+type ObjectConstraint struct {
+	additionalProperties Constraint
+	defaultValue         interface{}
+	hasDefault           bool
+	minProperties        int
+	maxProperties        int
+	options              ValidateOptions
+	patternProperties    map[*regexp.Regexp]Constraint
+	properties           map[string]Constraint
+	propdeps             map[string][]string
+	reqlock              sync.Mutex
+	required             map[string]struct{}
+	proplock             sync.Mutex
+	deplock              sync.Mutex
+	schemadeps           map[string]Constraint
+
+	propertyNames            Constraint
+	conditional              *ConditionalConstraint
+	unevaluatedProperties    Constraint
+	hasUnevaluatedProperties bool
+	tagName                  string
+
+	// FieldNamesFromStruct and FieldIndexFromName, when set, override
+	// the package-level defaults used to map a Go struct's fields to
+	// JSON property names.
+	FieldNamesFromStruct FieldNamesFromStructFunc
+	FieldIndexFromName   FieldIndexFromNameFunc
+}
+
+var zeroval reflect.Value
@@ -0,0 +1,85 @@
+package jsval_test
+
+import (
+	"testing"
+
+	"github.com/GlenDC/go-jsval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectDefaultNotAppliedWithoutApplyDefaults(t *testing.T) {
+	c := jsval.Object().
+		AddProp("role", jsval.String().Default("member"))
+
+	m := map[string]interface{}{}
+	assert.NoError(t, c.Validate(m))
+	_, ok := m["role"]
+	assert.False(t, ok, "default should not be written unless ApplyDefaults(true) is set")
+}
+
+func TestObjectApplyDefaultsToMap(t *testing.T) {
+	c := jsval.Object().
+		AddProp("role", jsval.String().Default("member")).
+		ApplyDefaults(true)
+
+	m := map[string]interface{}{}
+	assert.NoError(t, c.Validate(m))
+	assert.Equal(t, "member", m["role"])
+}
+
+func TestObjectApplyDefaultsLeavesExistingStructFieldAlone(t *testing.T) {
+	// A struct field is always present, even at its zero value, so it
+	// never counts as "missing" the way an absent map key does; the
+	// default is not written over it.
+	type account struct {
+		Role string `json:"role"`
+	}
+
+	c := jsval.Object().
+		AddProp("role", jsval.String().Default("member")).
+		ApplyDefaults(true)
+
+	a := &account{}
+	assert.NoError(t, c.Validate(a))
+	assert.Equal(t, "", a.Role)
+}
+
+func TestObjectApplyDefaultsSatisfiesRequired(t *testing.T) {
+	c := jsval.Object().
+		AddProp("role", jsval.String().Default("member")).
+		Required("role").
+		ApplyDefaults(true)
+
+	m := map[string]interface{}{}
+	assert.NoError(t, c.Validate(m), "a required property with a default should be filled in and accepted")
+	assert.Equal(t, "member", m["role"])
+}
+
+func TestObjectApplyDefaultsPropagatesToNestedObject(t *testing.T) {
+	// ApplyDefaults rides the shared ValidationContext the same way
+	// AccumulateErrors does, so a nested ObjectConstraint inherits it
+	// from the outer constraint without calling ApplyDefaults(true)
+	// itself.
+	inner := jsval.Object().AddProp("role", jsval.String().Default("member"))
+	outer := jsval.Object().
+		AddProp("account", inner).
+		ApplyDefaults(true)
+
+	m := map[string]interface{}{"account": map[string]interface{}{}}
+	assert.NoError(t, outer.Validate(m))
+
+	account, ok := m["account"].(map[string]interface{})
+	if assert.True(t, ok) {
+		assert.Equal(t, "member", account["role"])
+	}
+}
+
+func TestObjectRequiredStillFailsWithoutDefault(t *testing.T) {
+	c := jsval.Object().
+		AddProp("role", jsval.String()).
+		Required("role").
+		ApplyDefaults(true)
+
+	err := c.Validate(map[string]interface{}{})
+	assert.Error(t, err, "required property with no default must still fail")
+}
@@ -0,0 +1,205 @@
+package jsval
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ErrorKind identifies which constraint produced a FieldError, so
+// callers can switch on it without parsing the message text.
+type ErrorKind string
+
+// Known error kinds produced by the constraints in this package.
+const (
+	ErrorKindRequired              ErrorKind = "required"
+	ErrorKindMinProperties         ErrorKind = "minProperties"
+	ErrorKindMaxProperties         ErrorKind = "maxProperties"
+	ErrorKindProperties            ErrorKind = "properties"
+	ErrorKindPatternProperties     ErrorKind = "patternProperties"
+	ErrorKindAdditionalProperties  ErrorKind = "additionalProperties"
+	ErrorKindDependencies          ErrorKind = "dependencies"
+	ErrorKindPropertyNames         ErrorKind = "propertyNames"
+	ErrorKindConditional           ErrorKind = "conditional"
+	ErrorKindUnevaluatedProperties ErrorKind = "unevaluatedProperties"
+)
+
+// FieldError describes a single validation failure, including the
+// JSON Pointer path of the offending value (e.g. "/address/zip"),
+// the kind of constraint that rejected it, the value itself, and
+// the underlying cause, if any.
+type FieldError struct {
+	Path  string
+	Kind  ErrorKind
+	Value interface{}
+	Cause error
+}
+
+func (e *FieldError) Error() string {
+	var buf bytes.Buffer
+	if e.Path == "" {
+		buf.WriteString("/")
+	} else {
+		buf.WriteString(e.Path)
+	}
+	buf.WriteString(": ")
+	if e.Cause != nil {
+		buf.WriteString(e.Cause.Error())
+	} else {
+		fmt.Fprintf(&buf, "%s validation failed", e.Kind)
+	}
+	return buf.String()
+}
+
+// Unwrap allows `errors.Is` / `errors.As` to reach the underlying cause.
+func (e *FieldError) Unwrap() error {
+	return e.Cause
+}
+
+// ValidationErrors is satisfied by the error returned from a Validate
+// call performed in accumulation mode: it gives access to every
+// FieldError gathered during that single pass, rather than just the
+// first one encountered.
+type ValidationErrors interface {
+	error
+	Errors() []*FieldError
+}
+
+// multiError is the concrete ValidationErrors implementation returned
+// when AccumulateErrors(true) is in effect.
+type multiError struct {
+	errs []*FieldError
+}
+
+func (m *multiError) Error() string {
+	if len(m.errs) == 1 {
+		return m.errs[0].Error()
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%d validation errors occurred:\n", len(m.errs))
+	for _, e := range m.errs {
+		buf.WriteString("  * ")
+		buf.WriteString(e.Error())
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func (m *multiError) Errors() []*FieldError {
+	return m.errs
+}
+
+// ValidateOptions controls how a Constraint performs validation.
+type ValidateOptions struct {
+	// AccumulateErrors, when true, makes Validate gather every
+	// violation found during a single pass instead of returning as
+	// soon as the first one is found.
+	AccumulateErrors bool
+
+	// ApplyDefaults, when true, makes Validate write a missing
+	// property's declared default value back into the target. It
+	// rides the same ValidationContext as AccumulateErrors, so a
+	// nested ObjectConstraint inherits it from its parent without
+	// needing its own ApplyDefaults(true) call.
+	ApplyDefaults bool
+}
+
+// ValidationContext is threaded through a validation pass so that
+// nested constraints can report errors against the correct JSON
+// Pointer path and, in accumulation mode, contribute to a shared
+// list of errors rather than failing fast.
+type ValidationContext struct {
+	Path      string
+	Options   ValidateOptions
+	acc       *[]*FieldError
+	evaluated *map[string]struct{}
+}
+
+// NewValidationContext creates a root ValidationContext (empty Path,
+// the JSON Pointer to the whole value) with the given options.
+func NewValidationContext(options ValidateOptions) *ValidationContext {
+	return &ValidationContext{
+		Path:      "",
+		Options:   options,
+		acc:       &[]*FieldError{},
+		evaluated: &map[string]struct{}{},
+	}
+}
+
+// Child returns a new ValidationContext scoped to the named child
+// property, sharing the same options and error accumulator. Its set
+// of evaluated property names starts empty, as it describes the child
+// value rather than the parent.
+func (ctx *ValidationContext) Child(name string) *ValidationContext {
+	return &ValidationContext{
+		Path:      ctx.Path + "/" + name,
+		Options:   ctx.Options,
+		acc:       ctx.acc,
+		evaluated: &map[string]struct{}{},
+	}
+}
+
+// MarkEvaluated records that the named properties (of the value
+// currently being validated) have been accounted for by some
+// constraint, so that UnevaluatedProperties doesn't also reject them.
+func (ctx *ValidationContext) MarkEvaluated(names ...string) {
+	for _, n := range names {
+		(*ctx.evaluated)[n] = struct{}{}
+	}
+}
+
+// IsEvaluated reports whether name was previously passed to MarkEvaluated.
+func (ctx *ValidationContext) IsEvaluated(name string) bool {
+	_, ok := (*ctx.evaluated)[name]
+	return ok
+}
+
+// AddError records a FieldError against this context's path. It
+// returns the error itself (or nil, once accumulated) so callers can
+// still `return ctx.AddError(...)` when not accumulating.
+func (ctx *ValidationContext) AddError(kind ErrorKind, value interface{}, cause error) error {
+	fe := &FieldError{Path: ctx.Path, Kind: kind, Value: value, Cause: cause}
+	if !ctx.Options.AccumulateErrors {
+		return fe
+	}
+
+	*ctx.acc = append(*ctx.acc, fe)
+	return nil
+}
+
+// Err returns the accumulated errors as a ValidationErrors, or nil if
+// none were recorded. Only meaningful in accumulation mode.
+func (ctx *ValidationContext) Err() error {
+	if ctx.acc == nil || len(*ctx.acc) == 0 {
+		return nil
+	}
+	return &multiError{errs: *ctx.acc}
+}
+
+// ctxValidator is implemented by constraints (currently only
+// ObjectConstraint) that know how to carry a ValidationContext
+// through nested validation instead of starting a fresh one. It lets
+// validateNested recurse into a nested object's own properties/
+// patternProperties/etc. without losing the caller's path, options,
+// or shared accumulator.
+type ctxValidator interface {
+	validateCtx(ctx *ValidationContext, v interface{}) error
+}
+
+// validateNested validates v against c, reporting a violation under
+// ctx (tagged with kind) the same way regardless of whether c
+// understands ValidationContext: if it does (e.g. a nested
+// ObjectConstraint), ctx is threaded straight through so the violation
+// already carries the right path and respects the caller's
+// accumulate-errors setting; otherwise the plain error from
+// c.Validate is wrapped into a FieldError at ctx's path.
+func validateNested(ctx *ValidationContext, kind ErrorKind, c Constraint, v interface{}) error {
+	if cc, ok := c.(ctxValidator); ok {
+		return cc.validateCtx(ctx, v)
+	}
+
+	if err := c.Validate(v); err != nil {
+		return ctx.AddError(kind, v, err)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package jsval_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/GlenDC/go-jsval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectValidateFailFast(t *testing.T) {
+	c := jsval.Object().
+		AddProp("name", jsval.String()).
+		Required("name")
+
+	err := c.Validate(map[string]interface{}{})
+	if !assert.Error(t, err, "missing required property should fail") {
+		return
+	}
+
+	var fe *jsval.FieldError
+	if !assert.True(t, errors.As(err, &fe), "error should be a *FieldError") {
+		return
+	}
+	assert.Equal(t, jsval.ErrorKindRequired, fe.Kind)
+	assert.Equal(t, "/name", fe.Path)
+}
+
+func TestObjectValidatePathIsPointer(t *testing.T) {
+	address := jsval.Object().
+		AddProp("zip", jsval.String().MinLength(5))
+	c := jsval.Object().
+		AddProp("address", address)
+
+	err := c.Validate(map[string]interface{}{
+		"address": map[string]interface{}{"zip": "123"},
+	})
+	if !assert.Error(t, err) {
+		return
+	}
+
+	var fe *jsval.FieldError
+	if !assert.True(t, errors.As(err, &fe)) {
+		return
+	}
+	assert.Equal(t, "/address/zip", fe.Path, "nested violation should report the full path from the root")
+}
+
+func TestObjectValidateAccumulateErrors(t *testing.T) {
+	c := jsval.Object().
+		AddProp("name", jsval.String()).
+		AddProp("email", jsval.String()).
+		Required("name", "email").
+		AccumulateErrors(true)
+
+	err := c.Validate(map[string]interface{}{})
+	if !assert.Error(t, err) {
+		return
+	}
+
+	var verrs jsval.ValidationErrors
+	if !assert.True(t, errors.As(err, &verrs), "accumulated error should satisfy ValidationErrors") {
+		return
+	}
+	assert.Len(t, verrs.Errors(), 2, "both required violations should be reported")
+}
+
+func TestObjectValidateAccumulateErrorsPropagatesToNestedObject(t *testing.T) {
+	address := jsval.Object().
+		AddProp("street", jsval.String()).
+		AddProp("zip", jsval.String()).
+		Required("street", "zip")
+	c := jsval.Object().
+		AddProp("address", address).
+		AccumulateErrors(true)
+
+	err := c.Validate(map[string]interface{}{
+		"address": map[string]interface{}{},
+	})
+	if !assert.Error(t, err) {
+		return
+	}
+
+	var verrs jsval.ValidationErrors
+	if !assert.True(t, errors.As(err, &verrs)) {
+		return
+	}
+	assert.Len(t, verrs.Errors(), 2, "accumulate mode on the outer constraint should also collect both nested violations")
+}
@@ -0,0 +1,56 @@
+package jsval_test
+
+import (
+	"testing"
+
+	"github.com/GlenDC/go-jsval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatRegistryRegisterAndLookup(t *testing.T) {
+	reg := jsval.NewFormatRegistry()
+
+	_, ok := reg.Lookup("custom")
+	assert.False(t, ok, "empty registry should not know any formats")
+
+	reg.Register("custom", func(s string) error { return nil })
+	fn, ok := reg.Lookup("custom")
+	if assert.True(t, ok) {
+		assert.NoError(t, fn("anything"))
+	}
+}
+
+func TestDefaultFormatsBuiltins(t *testing.T) {
+	cases := []struct {
+		format  string
+		valid   string
+		invalid string
+	}{
+		{"date-time", "2024-01-02T15:04:05Z", "not-a-date-time"},
+		{"date", "2024-01-02", "01/02/2024"},
+		{"email", "user@example.com", "not-an-email"},
+		{"hostname", "example.com", "-bad-.com"},
+		{"ipv4", "127.0.0.1", "::1"},
+		{"ipv6", "::1", "127.0.0.1"},
+		{"uri", "https://example.com/path", "not a uri"},
+		{"uuid", "123e4567-e89b-12d3-a456-426614174000", "not-a-uuid"},
+	}
+
+	for _, tc := range cases {
+		fn, ok := jsval.DefaultFormats.Lookup(tc.format)
+		if !assert.True(t, ok, "format %q should be registered by default", tc.format) {
+			continue
+		}
+		assert.NoError(t, fn(tc.valid), "format %q should accept %q", tc.format, tc.valid)
+		assert.Error(t, fn(tc.invalid), "format %q should reject %q", tc.format, tc.invalid)
+	}
+}
+
+func TestRegisterFormatAddsToDefaultFormats(t *testing.T) {
+	jsval.RegisterFormat("test-only-format", func(s string) error { return nil })
+
+	fn, ok := jsval.DefaultFormats.Lookup("test-only-format")
+	if assert.True(t, ok) {
+		assert.NoError(t, fn("anything"))
+	}
+}
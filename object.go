@@ -22,6 +22,24 @@ func Object() *ObjectConstraint {
 	}
 }
 
+// Default specifies the default value to use for this constraint
+// when the value being validated is absent.
+func (o *ObjectConstraint) Default(v interface{}) *ObjectConstraint {
+	o.defaultValue = v
+	o.hasDefault = true
+	return o
+}
+
+// HasDefault returns true if a default value has been specified
+func (o *ObjectConstraint) HasDefault() bool {
+	return o.hasDefault
+}
+
+// DefaultValue returns the default value associated with this constraint
+func (o *ObjectConstraint) DefaultValue() interface{} {
+	return o.defaultValue
+}
+
 // Required specifies required property names
 func (o *ObjectConstraint) Required(l ...string) *ObjectConstraint {
 	o.reqlock.Lock()
@@ -151,11 +169,7 @@ func (o *ObjectConstraint) getPropNames(rv reflect.Value) ([]string, error) {
 			keys[i] = v.String()
 		}
 	case reflect.Struct:
-		fetcher := o.FieldNamesFromStruct
-		if fetcher == nil {
-			fetcher = DefaultFieldNamesFromStruct
-		}
-		if keys = fetcher(rv); keys == nil {
+		if keys = o.namesFetcher()(rv); keys == nil {
 			// Can't happen, because we check for reflect.Struct,
 			// but for completeness
 			return nil, errors.New("panic: can only handle structs")
@@ -167,28 +181,175 @@ func (o *ObjectConstraint) getPropNames(rv reflect.Value) ([]string, error) {
 	return keys, nil
 }
 
+// namesFetcher returns the FieldNamesFromStructFunc this object
+// should use: an explicit override, one built from TagName, or the
+// "json"-tag-aware DefaultFieldNamesFromStruct.
+func (o *ObjectConstraint) namesFetcher() FieldNamesFromStructFunc {
+	if o.FieldNamesFromStruct != nil {
+		return o.FieldNamesFromStruct
+	}
+	if o.tagName != "" {
+		return FieldNamesFromStructTag(o.tagName)
+	}
+	return DefaultFieldNamesFromStruct
+}
+
+// indexFetcher is the FieldIndexFromNameFunc counterpart of namesFetcher.
+func (o *ObjectConstraint) indexFetcher() FieldIndexFromNameFunc {
+	if o.FieldIndexFromName != nil {
+		return o.FieldIndexFromName
+	}
+	if o.tagName != "" {
+		return FieldIndexFromNameTag(o.tagName)
+	}
+	return DefaultFieldIndexFromName
+}
+
+// TagName selects the struct tag namespace (e.g. "json", the default,
+// or a custom request/response tag) consulted when discovering fields
+// on a struct being validated. A `jsval:"..."` tag, if present on a
+// field, always takes precedence as a per-field override.
+func (o *ObjectConstraint) TagName(name string) *ObjectConstraint {
+	o.tagName = name
+	return o
+}
+
+// setProp writes val back into rv's property named pname, converting
+// it to the property's declared type if necessary. It reports whether
+// the write could be performed: rv must be a settable map or a struct
+// with an exported, settable field of that name.
+func (o *ObjectConstraint) setProp(rv reflect.Value, pname string, val reflect.Value) bool {
+	if !val.IsValid() { // e.g. a nil default
+		return false
+	}
+	switch rv.Kind() {
+	case reflect.Map:
+		if rv.IsNil() || rv.Type().Key().Kind() != reflect.String {
+			return false
+		}
+		et := rv.Type().Elem()
+		if !val.Type().ConvertibleTo(et) {
+			return false
+		}
+		rv.SetMapIndex(reflect.ValueOf(pname).Convert(rv.Type().Key()), val.Convert(et))
+		return true
+	case reflect.Struct:
+		index := o.indexFetcher()(rv, pname)
+		if index == nil {
+			return false
+		}
+		fv, ok := fieldByIndex(rv, index)
+		if !ok || !fv.CanSet() || !val.Type().ConvertibleTo(fv.Type()) {
+			return false
+		}
+		fv.Set(val.Convert(fv.Type()))
+		return true
+	default:
+		return false
+	}
+}
+
 func (o *ObjectConstraint) getProp(rv reflect.Value, pname string) reflect.Value {
 	switch rv.Kind() {
 	case reflect.Map:
 		pv := reflect.ValueOf(pname)
 		return rv.MapIndex(pv)
 	case reflect.Struct:
-		fetcher := o.FieldIndexFromName
-		if fetcher == nil {
-			fetcher = DefaultFieldIndexFromName
+		index := o.indexFetcher()(rv, pname)
+		if index == nil {
+			return zeroval
 		}
-		i := fetcher(rv, pname)
-		if i < 0 {
+		fv, ok := fieldByIndex(rv, index)
+		if !ok {
 			return zeroval
 		}
-		return rv.Field(i)
+		return fv
 	default:
 		return zeroval
 	}
 }
 
+// AccumulateErrors controls whether Validate stops at the first
+// violation (the default) or gathers every violation it finds in a
+// single pass. When enabled, the error returned from Validate (if
+// any) satisfies ValidationErrors, except where the value, or a nested
+// value validated by an ObjectConstraint, is not an object at all:
+// that ends the pass with a plain error.
+func (o *ObjectConstraint) AccumulateErrors(b bool) *ObjectConstraint {
+	o.options.AccumulateErrors = b
+	return o
+}
+
+// ApplyDefaults controls whether Validate writes a property's
+// declared default value back into the target when that property is
+// missing. Like AccumulateErrors, this rides the shared
+// ValidationContext, so it also applies to any nested ObjectConstraint
+// encountered during validation without needing its own
+// ApplyDefaults(true) call. Defaults are written before validation
+// runs against them, so a required property with a default is filled
+// in and then validated rather than rejected as missing.
+//
+// Writing a default back only works against a non-nil map target: a
+// struct field is never "missing" the way an absent map key is (it
+// always holds at least its zero value), and a field promoted through
+// a nil pointer to an embedded struct counts as missing but is not
+// written either, since that pointer is not allocated.
+func (o *ObjectConstraint) ApplyDefaults(b bool) *ObjectConstraint {
+	o.options.ApplyDefaults = b
+	return o
+}
+
+// WithOptions replaces this ObjectConstraint's ValidateOptions wholesale.
+func (o *ObjectConstraint) WithOptions(options ValidateOptions) *ObjectConstraint {
+	o.options = options
+	return o
+}
+
+// PropertyNames specifies a constraint that every property name (key)
+// of the value being validated must satisfy, typically a
+// StringConstraint with MaxLength/Pattern set.
+func (o *ObjectConstraint) PropertyNames(c Constraint) *ObjectConstraint {
+	o.propertyNames = c
+	return o
+}
+
+// If starts an `if`/`then`/`else` conditional for this object: cond is
+// evaluated against the value being validated, and the Then or Else
+// branch chained off of the returned ConditionalConstraint is applied
+// depending on the outcome.
+func (o *ObjectConstraint) If(cond Constraint) *ConditionalConstraint {
+	o.conditional = If(cond)
+	return o.conditional
+}
+
+// UnevaluatedProperties specifies the constraint that any property not
+// already accounted for by AddProp, PatternProperties, or the If/Then/Else
+// branches must satisfy. When this is never called, no such check runs
+// and leftover properties are handled by AdditionalProperties alone
+// (rejected when that is unset); calling UnevaluatedProperties(nil)
+// explicitly rejects every one of them, mirroring
+// `unevaluatedProperties: false`. It has no effect when
+// AdditionalProperties is set, since that already evaluates every
+// leftover property.
+func (o *ObjectConstraint) UnevaluatedProperties(c Constraint) *ObjectConstraint {
+	o.unevaluatedProperties = c
+	o.hasUnevaluatedProperties = true
+	return o
+}
+
 // Validate validates the given value against this ObjectConstraint
 func (o *ObjectConstraint) Validate(v interface{}) (err error) {
+	ctx := NewValidationContext(o.options)
+	if err := o.validateCtx(ctx, v); err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// validateCtx returns nil once it has run to the end; in accumulation
+// mode the violations it found stay in ctx, to be collected by the
+// root Validate call, so that a nested object does not end the pass.
+func (o *ObjectConstraint) validateCtx(ctx *ValidationContext, v interface{}) (err error) {
 	if pdebug.Enabled {
 		g := pdebug.IPrintf("START ObjectConstraint.Validate")
 		defer func() {
@@ -211,12 +372,26 @@ func (o *ObjectConstraint) Validate(v interface{}) (err error) {
 		return err
 	}
 
+	if o.propertyNames != nil {
+		for _, name := range fields {
+			if err := o.propertyNames.Validate(name); err != nil {
+				if err := ctx.Child(name).AddError(ErrorKindPropertyNames, name, err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	lf := len(fields)
 	if o.minProperties > -1 && lf < o.minProperties {
-		return errors.New("fewer properties than minProperties")
+		if err := ctx.AddError(ErrorKindMinProperties, lf, errors.New("fewer properties than minProperties")); err != nil {
+			return err
+		}
 	}
 	if o.maxProperties > -1 && lf > o.maxProperties {
-		return errors.New("more properties than maxProperties")
+		if err := ctx.AddError(ErrorKindMaxProperties, lf, errors.New("more properties than maxProperties")); err != nil {
+			return err
+		}
 	}
 
 	// Find the list of field names that were passed to us
@@ -242,24 +417,32 @@ func (o *ObjectConstraint) Validate(v interface{}) (err error) {
 			pdebug.Printf("Validating property '%s'", pname)
 		}
 
+		pctx := ctx.Child(pname)
 		pval := o.getProp(rv, pname)
 		if pval == zeroval {
 			if pdebug.Enabled {
 				pdebug.Printf("Property '%s' does not exist", pname)
 			}
-			if o.IsPropRequired(pname) { // required, and not present.
-				return errors.New("object property '" + pname + "' is required")
-			}
 
-			// At this point we know that the property was not present
-			// and that this field was indeed not required.
+			// At this point we know that the property was not present.
+			// If it has a default, apply it (when enabled) before
+			// checking for a required violation, so that a required
+			// property with a default counts as satisfied.
 			if c.HasDefault() {
-				// We have default
 				dv := c.DefaultValue()
-				pval = reflect.ValueOf(dv)
+				if ctx.Options.ApplyDefaults && o.setProp(rv, pname, reflect.ValueOf(dv)) {
+					pval = o.getProp(rv, pname)
+				}
 			}
 
-			continue
+			if pval == zeroval {
+				if o.IsPropRequired(pname) { // required, and not present.
+					if err := pctx.AddError(ErrorKindRequired, nil, errors.New("object property '"+pname+"' is required")); err != nil {
+						return err
+					}
+				}
+				continue
+			}
 		}
 
 		// delete from remaining props
@@ -267,8 +450,8 @@ func (o *ObjectConstraint) Validate(v interface{}) (err error) {
 		// ...and add to props that we have seen
 		pseen[pname] = struct{}{}
 
-		if err := c.Validate(pval.Interface()); err != nil {
-			return errors.New("object property '" + pname + "' validation failed: " + err.Error())
+		if err := validateNested(pctx, ErrorKindProperties, c, pval.Interface()); err != nil {
+			return err
 		}
 	}
 
@@ -283,22 +466,65 @@ func (o *ObjectConstraint) Validate(v interface{}) (err error) {
 
 			delete(premain, pname)
 			pseen[pname] = struct{}{}
-			if err := c.Validate(pval.Interface()); err != nil {
-				return errors.New("object property '" + pname + "' validation failed: " + err.Error())
+			if err := validateNested(ctx.Child(pname), ErrorKindPatternProperties, c, pval.Interface()); err != nil {
+				return err
 			}
 		}
 	}
 
+	// premain left over after direct + pattern properties is what
+	// unevaluatedProperties cares about; additionalProperties, when
+	// configured, already evaluates everything that remains.
+	preUnevaluated := make(map[string]struct{}, len(premain))
+	for pname := range premain {
+		preUnevaluated[pname] = struct{}{}
+	}
+
 	if len(premain) > 0 {
 		c := o.additionalProperties
-		if c == nil {
-			return errors.New("additional items are not allowed")
+		if c == nil && !o.hasUnevaluatedProperties {
+			if err := ctx.AddError(ErrorKindAdditionalProperties, nil, errors.New("additional items are not allowed")); err != nil {
+				return err
+			}
+		} else if c != nil {
+			for pname := range premain {
+				pval := o.getProp(rv, pname)
+				if err := validateNested(ctx.Child(pname), ErrorKindAdditionalProperties, c, pval.Interface()); err != nil {
+					return err
+				}
+				pseen[pname] = struct{}{}
+			}
 		}
+	}
+
+	for pname := range pseen {
+		ctx.MarkEvaluated(pname)
+	}
+
+	if o.conditional != nil {
+		if branch := o.conditional.branch(v); branch != nil {
+			if err := validateNested(ctx, ErrorKindConditional, branch, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.hasUnevaluatedProperties && o.additionalProperties == nil {
+		for pname := range preUnevaluated {
+			if ctx.IsEvaluated(pname) {
+				continue
+			}
 
-		for pname := range premain {
 			pval := o.getProp(rv, pname)
-			if err := c.Validate(pval.Interface()); err != nil {
-				return errors.New("object property for '" + pname + "' validation failed: " + err.Error())
+			pctx := ctx.Child(pname)
+			if o.unevaluatedProperties == nil {
+				if err := pctx.AddError(ErrorKindUnevaluatedProperties, pval.Interface(), errors.New("unevaluated properties are not allowed")); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := validateNested(pctx, ErrorKindUnevaluatedProperties, o.unevaluatedProperties, pval.Interface()); err != nil {
+				return err
 			}
 		}
 	}
@@ -310,7 +536,9 @@ func (o *ObjectConstraint) Validate(v interface{}) (err error) {
 			}
 			for _, dep := range deps {
 				if _, ok := pseen[dep]; !ok {
-					return errors.New("required dependency '" + dep + "' is mising")
+					if err := ctx.Child(pname).AddError(ErrorKindDependencies, dep, errors.New("required dependency '"+dep+"' is mising")); err != nil {
+						return err
+					}
 				}
 			}
 
@@ -319,7 +547,7 @@ func (o *ObjectConstraint) Validate(v interface{}) (err error) {
 		}
 
 		if depc := o.GetSchemaDependency(pname); depc != nil {
-			if err := depc.Validate(v); err != nil {
+			if err := validateNested(ctx, ErrorKindDependencies, depc, v); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,138 @@
+package jsval
+
+import (
+	"errors"
+	"regexp"
+)
+
+// String creates a new StringConstraint
+func String() *StringConstraint {
+	return &StringConstraint{
+		maxLength: -1,
+		minLength: -1,
+		formats:   DefaultFormats,
+	}
+}
+
+// StringConstraint validates that a value is a string of a given
+// length, matching an optional pattern and an optional named format
+// (e.g. "date-time", "email").
+type StringConstraint struct {
+	defaultValue  interface{}
+	hasDefault    bool
+	format        string
+	formats       *FormatRegistry
+	ignoreUnknown bool
+	maxLength     int
+	minLength     int
+	regexp        *regexp.Regexp
+}
+
+// Default specifies the default value to use for this constraint
+// when the value being validated is absent.
+func (s *StringConstraint) Default(v interface{}) *StringConstraint {
+	s.defaultValue = v
+	s.hasDefault = true
+	return s
+}
+
+// HasDefault returns true if a default value has been specified
+func (s *StringConstraint) HasDefault() bool {
+	return s.hasDefault
+}
+
+// DefaultValue returns the default value associated with this constraint
+func (s *StringConstraint) DefaultValue() interface{} {
+	return s.defaultValue
+}
+
+// MaxLength specifies the maximum length allowed for this string.
+// If unspecified, it is not checked.
+func (s *StringConstraint) MaxLength(n int) *StringConstraint {
+	s.maxLength = n
+	return s
+}
+
+// MinLength specifies the minimum length allowed for this string.
+// If unspecified, it is not checked.
+func (s *StringConstraint) MinLength(n int) *StringConstraint {
+	s.minLength = n
+	return s
+}
+
+// RegexpString compiles pat and uses it as this constraint's pattern.
+// It panics if pat fails to compile, mirroring regexp.MustCompile.
+func (s *StringConstraint) RegexpString(pat string) *StringConstraint {
+	return s.Regexp(regexp.MustCompile(pat))
+}
+
+// Regexp specifies the pattern that this string must match.
+func (s *StringConstraint) Regexp(rx *regexp.Regexp) *StringConstraint {
+	s.regexp = rx
+	return s
+}
+
+// Format specifies the name of a registered string format (e.g.
+// "date-time", "email", "uuid") that this string must satisfy. The
+// name is looked up in WithFormats' registry (DefaultFormats unless
+// overridden) at validation time, so formats registered after this
+// call still apply.
+func (s *StringConstraint) Format(name string) *StringConstraint {
+	s.format = name
+	return s
+}
+
+// WithFormats overrides the FormatRegistry consulted by Format,
+// instead of the package-level DefaultFormats.
+func (s *StringConstraint) WithFormats(reg *FormatRegistry) *StringConstraint {
+	s.formats = reg
+	return s
+}
+
+// IgnoreUnknownFormat controls what happens when Format names a
+// format that isn't registered: by default Validate fails, but
+// IgnoreUnknownFormat(true) makes it pass silently instead (useful
+// while formats are still being rolled out across a registry).
+func (s *StringConstraint) IgnoreUnknownFormat(b bool) *StringConstraint {
+	s.ignoreUnknown = b
+	return s
+}
+
+// Validate validates the given value against this StringConstraint
+func (s *StringConstraint) Validate(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return errors.New("value is not a string")
+	}
+
+	if s.maxLength > -1 && len(str) > s.maxLength {
+		return errors.New("string longer than maxLength")
+	}
+	if s.minLength > -1 && len(str) < s.minLength {
+		return errors.New("string shorter than minLength")
+	}
+	if s.regexp != nil && !s.regexp.MatchString(str) {
+		return errors.New("string does not match pattern")
+	}
+
+	if s.format != "" {
+		reg := s.formats
+		if reg == nil {
+			reg = DefaultFormats
+		}
+
+		fn, ok := reg.Lookup(s.format)
+		if !ok {
+			if s.ignoreUnknown {
+				return nil
+			}
+			return errors.New("unknown format '" + s.format + "'")
+		}
+
+		if err := fn(str); err != nil {
+			return errors.New("string does not satisfy format '" + s.format + "': " + err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package jsval_test
+
+import (
+	"testing"
+
+	"github.com/GlenDC/go-jsval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringConstraintBasic(t *testing.T) {
+	c := jsval.String().MinLength(2).MaxLength(4)
+
+	assert.NoError(t, c.Validate("ab"))
+	assert.NoError(t, c.Validate("abcd"))
+	assert.Error(t, c.Validate("a"), "shorter than minLength should fail")
+	assert.Error(t, c.Validate("abcde"), "longer than maxLength should fail")
+	assert.Error(t, c.Validate(42), "non-string value should fail")
+}
+
+func TestStringConstraintRegexp(t *testing.T) {
+	c := jsval.String().RegexpString(`^[a-z]+$`)
+
+	assert.NoError(t, c.Validate("abc"))
+	assert.Error(t, c.Validate("ABC"))
+}
+
+func TestStringConstraintFormat(t *testing.T) {
+	c := jsval.String().Format("email")
+
+	assert.NoError(t, c.Validate("user@example.com"))
+	assert.Error(t, c.Validate("not-an-email"))
+}
+
+func TestStringConstraintUnknownFormat(t *testing.T) {
+	c := jsval.String().Format("does-not-exist")
+	assert.Error(t, c.Validate("anything"), "unknown format should fail by default")
+
+	c.IgnoreUnknownFormat(true)
+	assert.NoError(t, c.Validate("anything"), "unknown format should pass when ignored")
+}
+
+func TestStringConstraintWithFormats(t *testing.T) {
+	reg := jsval.NewFormatRegistry()
+	reg.Register("digits-only", func(s string) error {
+		for _, r := range s {
+			if r < '0' || r > '9' {
+				return assert.AnError
+			}
+		}
+		return nil
+	})
+
+	c := jsval.String().Format("digits-only").WithFormats(reg)
+	assert.NoError(t, c.Validate("12345"))
+	assert.Error(t, c.Validate("12a45"))
+}
@@ -0,0 +1,217 @@
+package jsval
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// fieldSpec describes one JSON property discovered on a struct type:
+// the name it's validated under, and the FieldByIndex path (to reach
+// through flattened anonymous embedded structs) needed to read it.
+type fieldSpec struct {
+	Name  string
+	Index []int
+}
+
+type fieldCacheKey struct {
+	t   reflect.Type
+	tag string
+}
+
+// fieldByIndex walks index the same way reflect.Value.FieldByIndex
+// does, except that it treats a nil pointer to an embedded struct as
+// "the promoted field is absent" (ok == false) instead of panicking,
+// which FieldByIndex does on encountering one.
+func fieldByIndex(rv reflect.Value, index []int) (_ reflect.Value, ok bool) {
+	for i, x := range index {
+		if i > 0 {
+			if rv.Kind() == reflect.Ptr && rv.Type().Elem().Kind() == reflect.Struct {
+				if rv.IsNil() {
+					return reflect.Value{}, false
+				}
+				rv = rv.Elem()
+			}
+		}
+		rv = rv.Field(x)
+	}
+	return rv, true
+}
+
+// fieldCache memoizes the field discovery walk per (struct type, tag
+// name) pair, so repeated Validate calls against the same struct type
+// don't re-walk its fields (and those of any embedded structs) every time.
+var fieldCache sync.Map // fieldCacheKey -> []fieldSpec
+
+// secondaryTag is consulted before the configured tag namespace,
+// letting callers override field discovery without touching their
+// existing json tags.
+const secondaryTag = "jsval"
+
+// FieldNamesFromStructTag returns a FieldNamesFromStructFunc that
+// discovers fields the way encoding/json would when decoding into the
+// struct: it honors `{tagName}:"name,omitempty"` (including `-` to
+// skip the field and `jsval:"..."` as a per-field override), flattens
+// anonymous embedded structs so their promoted fields appear at the
+// parent level, and skips unexported fields. Unlike encoding/json, an
+// embedded struct of an unexported type is skipped together with the
+// fields it would promote.
+func FieldNamesFromStructTag(tagName string) FieldNamesFromStructFunc {
+	return func(rv reflect.Value) []string {
+		specs := fieldsForType(rv.Type(), tagName)
+		names := make([]string, len(specs))
+		for i, s := range specs {
+			names[i] = s.Name
+		}
+		return names
+	}
+}
+
+// FieldIndexFromNameTag returns a FieldIndexFromNameFunc matching the
+// same rules as FieldNamesFromStructTag.
+func FieldIndexFromNameTag(tagName string) FieldIndexFromNameFunc {
+	return func(rv reflect.Value, name string) []int {
+		specs := fieldsForType(rv.Type(), tagName)
+		for _, s := range specs {
+			if s.Name == name {
+				return s.Index
+			}
+		}
+		return nil
+	}
+}
+
+// DefaultFieldNamesFromStruct is the default FieldNamesFromStructFunc
+// used by ObjectConstraint when neither FieldNamesFromStruct nor
+// TagName is configured on it. It discovers fields by their "json" tag.
+func DefaultFieldNamesFromStruct(rv reflect.Value) []string {
+	return FieldNamesFromStructTag("json")(rv)
+}
+
+// DefaultFieldIndexFromName is the default FieldIndexFromNameFunc
+// used by ObjectConstraint when neither FieldIndexFromName nor
+// TagName is configured on it. It discovers fields by their "json" tag.
+func DefaultFieldIndexFromName(rv reflect.Value, name string) []int {
+	return FieldIndexFromNameTag("json")(rv, name)
+}
+
+// fieldCandidate is a fieldSpec still awaiting promoted-field conflict
+// resolution, tagged with how many embedding levels deep it was found.
+type fieldCandidate struct {
+	fieldSpec
+	depth int
+}
+
+func fieldsForType(rt reflect.Type, tagName string) []fieldSpec {
+	key := fieldCacheKey{t: rt, tag: tagName}
+	if v, ok := fieldCache.Load(key); ok {
+		return v.([]fieldSpec)
+	}
+
+	specs := resolveFields(collectFields(rt, tagName, nil, 0))
+	fieldCache.Store(key, specs)
+	return specs
+}
+
+func collectFields(rt reflect.Type, tagName string, parentIndex []int, depth int) []fieldCandidate {
+	var candidates []fieldCandidate
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if sf.PkgPath != "" { // unexported
+			continue
+		}
+
+		index := make([]int, len(parentIndex)+1)
+		copy(index, parentIndex)
+		index[len(parentIndex)] = i
+
+		tag, explicit := sf.Tag.Lookup(secondaryTag)
+		if !explicit {
+			tag, explicit = sf.Tag.Lookup(tagName)
+		}
+
+		name, omit := parseTag(tag)
+		if omit {
+			continue
+		}
+
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if sf.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			candidates = append(candidates, collectFields(ft, tagName, index, depth+1)...)
+			continue
+		}
+
+		if name == "" {
+			name = sf.Name
+		}
+
+		candidates = append(candidates, fieldCandidate{fieldSpec{Name: name, Index: index}, depth})
+	}
+	return candidates
+}
+
+// resolveFields applies a simplified form of encoding/json's
+// promoted-field conflict rule: for a given name, the shallowest
+// embedding depth wins; if more than one candidate shares that
+// shallowest depth, the name is ambiguous and dropped entirely rather
+// than picking one arbitrarily. Tags play no part here, so a tagged
+// field does not win over an untagged one at the same depth the way
+// it does in encoding/json.
+func resolveFields(candidates []fieldCandidate) []fieldSpec {
+	byName := make(map[string][]fieldCandidate, len(candidates))
+	order := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if _, ok := byName[c.Name]; !ok {
+			order = append(order, c.Name)
+		}
+		byName[c.Name] = append(byName[c.Name], c)
+	}
+
+	specs := make([]fieldSpec, 0, len(order))
+	for _, name := range order {
+		group := byName[name]
+
+		shallowest := group[0].depth
+		for _, c := range group[1:] {
+			if c.depth < shallowest {
+				shallowest = c.depth
+			}
+		}
+
+		var winner *fieldCandidate
+		for i, c := range group {
+			if c.depth != shallowest {
+				continue
+			}
+			if winner != nil {
+				winner = nil // ambiguous: two fields at the same shallowest depth
+				break
+			}
+			winner = &group[i]
+		}
+		if winner == nil {
+			continue
+		}
+
+		specs = append(specs, winner.fieldSpec)
+	}
+	return specs
+}
+
+// parseTag splits a "name,omitempty"-style tag value into its name
+// (empty if unspecified) and whether the field should be omitted
+// entirely (tag == "-").
+func parseTag(tag string) (name string, omit bool) {
+	if tag == "-" {
+		return "", true
+	}
+
+	if idx := strings.Index(tag, ","); idx >= 0 {
+		return tag[:idx], false
+	}
+	return tag, false
+}
@@ -0,0 +1,142 @@
+package jsval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFieldByIndexNilEmbeddedPointer(t *testing.T) {
+	type Inner struct {
+		Name string
+	}
+	type Outer struct {
+		*Inner
+	}
+
+	o := Outer{}
+	rv := reflect.ValueOf(o)
+
+	_, ok := fieldByIndex(rv, []int{0, 0})
+	if ok {
+		t.Fatal("expected ok=false when walking through a nil embedded pointer")
+	}
+}
+
+func TestFieldByIndexNonNilEmbeddedPointer(t *testing.T) {
+	type Inner struct {
+		Name string
+	}
+	type Outer struct {
+		*Inner
+	}
+
+	o := Outer{Inner: &Inner{Name: "hello"}}
+	rv := reflect.ValueOf(o)
+
+	fv, ok := fieldByIndex(rv, []int{0, 0})
+	if !ok {
+		t.Fatal("expected ok=true for a populated embedded pointer")
+	}
+	if fv.String() != "hello" {
+		t.Fatalf("expected %q, got %q", "hello", fv.String())
+	}
+}
+
+func TestFieldsForTypeFlattensEmbeddedStruct(t *testing.T) {
+	type Inner struct {
+		Name string `json:"name"`
+	}
+	type Outer struct {
+		Inner
+		Age int `json:"age"`
+	}
+
+	specs := fieldsForType(reflect.TypeOf(Outer{}), "json")
+	names := make(map[string][]int, len(specs))
+	for _, s := range specs {
+		names[s.Name] = s.Index
+	}
+
+	if _, ok := names["name"]; !ok {
+		t.Fatal("expected promoted field 'name' from the embedded struct")
+	}
+	if _, ok := names["age"]; !ok {
+		t.Fatal("expected direct field 'age'")
+	}
+}
+
+func TestFieldsForTypeShallowestWins(t *testing.T) {
+	type Grandparent struct {
+		Name string `json:"name"`
+	}
+	type Parent struct {
+		Grandparent
+	}
+	type Child struct {
+		Parent
+		Name string `json:"name"`
+	}
+
+	specs := fieldsForType(reflect.TypeOf(Child{}), "json")
+
+	var matches []fieldSpec
+	for _, s := range specs {
+		if s.Name == "name" {
+			matches = append(matches, s)
+		}
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected exactly one 'name' field (shallowest wins), got %d", len(matches))
+	}
+	if len(matches[0].Index) != 1 {
+		t.Fatalf("expected the direct field (depth 0) to win, got index %v", matches[0].Index)
+	}
+}
+
+func TestFieldsForTypeAmbiguousSameDepthDropped(t *testing.T) {
+	// Built with reflect.StructOf rather than two plain struct literals
+	// with the same json tag, which `go vet`'s structtag check flags
+	// as a static duplicate even though this is the exact runtime shape
+	// resolveFields needs to disambiguate.
+	named := func(name string) reflect.Type {
+		return reflect.StructOf([]reflect.StructField{
+			{Name: "Name", Type: reflect.TypeOf(""), Tag: reflect.StructTag(`json:"name"`)},
+		})
+	}
+	a, b := named("A"), named("B")
+	combined := reflect.StructOf([]reflect.StructField{
+		{Name: "A", Type: a, Anonymous: true},
+		{Name: "B", Type: b, Anonymous: true},
+	})
+
+	specs := fieldsForType(combined, "json")
+	for _, s := range specs {
+		if s.Name == "name" {
+			t.Fatalf("expected ambiguous same-depth 'name' field to be dropped, got index %v", s.Index)
+		}
+	}
+}
+
+func TestFieldsForTypeSkipsUnexportedAndDashTag(t *testing.T) {
+	type withSkips struct {
+		Visible    string `json:"visible"`
+		Hidden     string `json:"-"`
+		unexported string
+	}
+
+	specs := fieldsForType(reflect.TypeOf(withSkips{}), "json")
+	names := make(map[string]bool, len(specs))
+	for _, s := range specs {
+		names[s.Name] = true
+	}
+
+	if !names["visible"] {
+		t.Fatal("expected 'visible' field to be discovered")
+	}
+	if names["Hidden"] || names["-"] {
+		t.Fatal("field tagged json:\"-\" should be skipped")
+	}
+	if names["unexported"] {
+		t.Fatal("unexported field should be skipped")
+	}
+}